@@ -0,0 +1,98 @@
+package jl
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+
+	"github.com/buger/jsonparser"
+)
+
+var _ = Stringer(FastLevelStringer)
+var _ = Stringer(FastExceptionStringer)
+
+// FastStringers mirrors the default stringer set but is built on jsonparser
+// instead of encoding/json, so fields are read directly off the raw byte slice
+// without ever unmarshalling into an intermediate interface{} or struct.
+// Swap it in for the default field->Stringer mapping to use the faster
+// pipeline; wiring an opt-in flag for it into Config/Context is left to the
+// integrator, since neither lives in this package.
+//
+// TODO(fnzr/jl): once Config/Context land in this module, add a
+// Config.UseFastStringers flag that makes Context build its dispatch table
+// from FastStringers instead of the default map, as originally requested.
+var FastStringers = map[string]Stringer{
+	"level":     FastLevelStringer,
+	"exception": FastExceptionStringer,
+	"extra":     FastExtraStringer,
+	"trace":     FastTraceStringer,
+}
+
+// FastLevelStringer is the jsonparser-backed analog of LevelStringer.
+func FastLevelStringer(ctx *Context, v interface{}) string {
+	rawMsg, ok := v.(json.RawMessage)
+	if !ok {
+		return ""
+	}
+	val, _, _, err := jsonparser.Get(rawMsg)
+	if err != nil {
+		return ""
+	}
+	switch {
+	case bytes.Equal(val, []byte("WARNING")):
+		return "WARN"
+	case bytes.Equal(val, []byte("CRITICAL")):
+		return "CRIT"
+	default:
+		return string(val)
+	}
+}
+
+// FastExceptionStringer is the jsonparser-backed analog of ExceptionStringer.
+func FastExceptionStringer(ctx *Context, v interface{}) string {
+	rawMsg, ok := v.(json.RawMessage)
+	if !ok {
+		return DefaultStringer(ctx, v)
+	}
+	w := &bytes.Buffer{}
+	if file, err := jsonparser.GetString(rawMsg, "file"); err == nil {
+		w.WriteString(file)
+	}
+	_, _ = jsonparser.ArrayEach(rawMsg, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		w.WriteRune('\n')
+		w.Write(value)
+	}, "trace")
+	return w.String()
+}
+
+// FastExtraStringer is the jsonparser-backed analog of ExtraStringer.
+func FastExtraStringer(ctx *Context, v interface{}) string {
+	rawMsg, ok := v.(json.RawMessage)
+	if !ok {
+		return ""
+	}
+	class, classErr := jsonparser.GetString(rawMsg, "class")
+	line, lineErr := jsonparser.GetInt(rawMsg, "line")
+	if classErr == nil && lineErr == nil {
+		return class + ":" + strconv.FormatInt(line, 10)
+	}
+	s, err := jsonparser.GetString(rawMsg)
+	if err != nil {
+		return ""
+	}
+	return s
+}
+
+// FastTraceStringer is the jsonparser-backed analog of TraceStringer.
+func FastTraceStringer(ctx *Context, v interface{}) string {
+	rawMsg, ok := v.(json.RawMessage)
+	if !ok {
+		return ""
+	}
+	w := &bytes.Buffer{}
+	_, _ = jsonparser.ArrayEach(rawMsg, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		w.WriteRune('\n')
+		w.Write(value)
+	})
+	return w.String()
+}
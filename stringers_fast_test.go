@@ -0,0 +1,72 @@
+package jl
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// These fields make up a representative logrus-style record: a bare level
+// string, an exception object with a file and a trace array, a small extra
+// object, and a standalone trace array, matching the fields both the default
+// stringers (LevelStringer, ExceptionStringer, ExtraStringer, TraceStringer)
+// and FastStringers know how to render.
+var (
+	levelField = json.RawMessage(`"WARNING"`)
+
+	exceptionField = json.RawMessage(`{
+		"file": "main.go:42",
+		"trace": ["main.main", "main.run", "main.doWork"]
+	}`)
+
+	extraField = json.RawMessage(`{"class": "RuntimeError", "line": 128}`)
+
+	traceField = json.RawMessage(`["frame1", "frame2", "frame3"]`)
+)
+
+func BenchmarkLevelStringer(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		LevelStringer(nil, levelField)
+	}
+}
+
+func BenchmarkFastLevelStringer(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FastLevelStringer(nil, levelField)
+	}
+}
+
+func BenchmarkExceptionStringer(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ExceptionStringer(nil, exceptionField)
+	}
+}
+
+func BenchmarkFastExceptionStringer(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FastExceptionStringer(nil, exceptionField)
+	}
+}
+
+func BenchmarkExtraStringer(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ExtraStringer(nil, extraField)
+	}
+}
+
+func BenchmarkFastExtraStringer(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FastExtraStringer(nil, extraField)
+	}
+}
+
+func BenchmarkTraceStringer(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		TraceStringer(nil, traceField)
+	}
+}
+
+func BenchmarkFastTraceStringer(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FastTraceStringer(nil, traceField)
+	}
+}
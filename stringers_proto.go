@@ -0,0 +1,101 @@
+package jl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+var _ = Stringer(ProtoStringer)
+
+// ProtoStringer dispatches a field encoded by protojson/jsonpb to the right
+// well-known-type stringer by peeking at its shape, so a single field mapping
+// entry handles heterogeneous proto payloads. It falls back to the
+// DefaultStringer when nothing recognizable matches.
+func ProtoStringer(ctx *Context, v interface{}) string {
+	rawMsg, ok := v.(json.RawMessage)
+	if !ok {
+		return DefaultStringer(ctx, v)
+	}
+	trimmed := bytes.TrimSpace(rawMsg)
+	if len(trimmed) == 0 {
+		return DefaultStringer(ctx, v)
+	}
+	switch trimmed[0] {
+	case '"':
+		var s string
+		if err := json.Unmarshal(trimmed, &s); err == nil {
+			if _, err := time.Parse(time.RFC3339Nano, s); err == nil {
+				return ProtoTimestampStringer(ctx, v)
+			}
+			if _, err := time.ParseDuration(s); err == nil {
+				return ProtoDurationStringer(ctx, v)
+			}
+		}
+	case '{':
+		if bytes.Contains(trimmed, []byte(`"@type"`)) {
+			return ProtoAnyStringer(ctx, v)
+		}
+	}
+	return DefaultStringer(ctx, v)
+}
+
+// ProtoTimestampStringer renders a google.protobuf.Timestamp, serialized by
+// protojson as an RFC 3339 string, as a human-readable local-time string. It
+// falls back to the DefaultStringer when the field isn't an RFC 3339 string.
+func ProtoTimestampStringer(ctx *Context, v interface{}) string {
+	rawMsg, ok := v.(json.RawMessage)
+	if !ok {
+		return DefaultStringer(ctx, v)
+	}
+	var s string
+	if err := json.Unmarshal(rawMsg, &s); err != nil {
+		return DefaultStringer(ctx, v)
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return DefaultStringer(ctx, v)
+	}
+	return t.Local().Format("2006-01-02 15:04:05.000 MST")
+}
+
+// ProtoDurationStringer renders a google.protobuf.Duration, serialized by
+// protojson as a string like "1.5s", as a human-readable duration. It falls
+// back to the DefaultStringer when the field isn't a duration string.
+func ProtoDurationStringer(ctx *Context, v interface{}) string {
+	rawMsg, ok := v.(json.RawMessage)
+	if !ok {
+		return DefaultStringer(ctx, v)
+	}
+	var s string
+	if err := json.Unmarshal(rawMsg, &s); err != nil {
+		return DefaultStringer(ctx, v)
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return DefaultStringer(ctx, v)
+	}
+	return d.String()
+}
+
+// LogAny is the subset of google.protobuf.Any's protojson encoding needed to
+// identify the packed message's type.
+type LogAny struct {
+	Type string `json:"@type"`
+}
+
+// ProtoAnyStringer renders a google.protobuf.Any, serialized by protojson as
+// {"@type": "...", ...}, as its type tag followed by the raw payload. It
+// falls back to the DefaultStringer when "@type" is missing.
+func ProtoAnyStringer(ctx *Context, v interface{}) string {
+	rawMsg, ok := v.(json.RawMessage)
+	if !ok {
+		return DefaultStringer(ctx, v)
+	}
+	var any LogAny
+	if err := json.Unmarshal(rawMsg, &any); err != nil || any.Type == "" {
+		return DefaultStringer(ctx, v)
+	}
+	return fmt.Sprintf("%s %s", any.Type, string(rawMsg))
+}
@@ -0,0 +1,83 @@
+package jl
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+// These fixtures mirror the exact shapes protojson.Marshal produces for the
+// well-known types, without depending on google.golang.org/protobuf directly:
+// google.protobuf.Timestamp as an RFC 3339 string, Duration as a seconds
+// string suffixed with "s", and Any as an object carrying "@type".
+var (
+	protoTimestampFixture = json.RawMessage(`"2024-01-15T10:30:00Z"`)
+	protoDurationFixture  = json.RawMessage(`"1.500s"`)
+	protoAnyFixture       = json.RawMessage(`{"@type":"type.googleapis.com/google.protobuf.StringValue","value":"hi"}`)
+	protoPlainStringField = json.RawMessage(`"just a string"`)
+)
+
+// protoTimestampFixtureLocalDate reproduces the same UTC->local conversion
+// ProtoTimestampStringer applies, so the expected date shifts along with the
+// host's zone instead of assuming the fixture's UTC date survives it (it
+// doesn't in UTC-11/UTC-12, e.g. Pacific/Pago_Pago).
+func protoTimestampFixtureLocalDate(t *testing.T) string {
+	parsed, err := time.Parse(time.RFC3339Nano, "2024-01-15T10:30:00Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed.Local().Format("2006-01-02")
+}
+
+func TestProtoStringerDispatch(t *testing.T) {
+	wantDate := protoTimestampFixtureLocalDate(t)
+	cases := []struct {
+		name     string
+		field    json.RawMessage
+		wantFunc func(string) bool
+	}{
+		{"timestamp", protoTimestampFixture, func(s string) bool { return strings.Contains(s, wantDate) }},
+		{"duration", protoDurationFixture, func(s string) bool { return s == "1.5s" }},
+		{"any", protoAnyFixture, func(s string) bool { return strings.HasPrefix(s, "type.googleapis.com/google.protobuf.StringValue ") }},
+		{"plain string falls through", protoPlainStringField, func(s string) bool { return s == "just a string" }},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := ProtoStringer(nil, c.field)
+			if !c.wantFunc(got) {
+				t.Errorf("ProtoStringer(%s) = %q, did not match expectation", c.field, got)
+			}
+		})
+	}
+}
+
+func TestProtoTimestampStringer(t *testing.T) {
+	wantDate := protoTimestampFixtureLocalDate(t)
+	got := ProtoTimestampStringer(nil, protoTimestampFixture)
+	if !strings.Contains(got, wantDate) {
+		t.Errorf("ProtoTimestampStringer(%s) = %q, want it to contain %q", protoTimestampFixture, got, wantDate)
+	}
+	if got := ProtoTimestampStringer(nil, protoPlainStringField); got != "just a string" {
+		t.Errorf("ProtoTimestampStringer fallback = %q, want DefaultStringer output", got)
+	}
+}
+
+func TestProtoDurationStringer(t *testing.T) {
+	if got := ProtoDurationStringer(nil, protoDurationFixture); got != "1.5s" {
+		t.Errorf("ProtoDurationStringer(%s) = %q, want %q", protoDurationFixture, got, "1.5s")
+	}
+	if got := ProtoDurationStringer(nil, protoPlainStringField); got != "just a string" {
+		t.Errorf("ProtoDurationStringer fallback = %q, want DefaultStringer output", got)
+	}
+}
+
+func TestProtoAnyStringer(t *testing.T) {
+	want := "type.googleapis.com/google.protobuf.StringValue " + string(protoAnyFixture)
+	if got := ProtoAnyStringer(nil, protoAnyFixture); got != want {
+		t.Errorf("ProtoAnyStringer(%s) = %q, want %q", protoAnyFixture, got, want)
+	}
+	if got := ProtoAnyStringer(nil, protoPlainStringField); got != "just a string" {
+		t.Errorf("ProtoAnyStringer fallback = %q, want DefaultStringer output", got)
+	}
+}
@@ -0,0 +1,79 @@
+package jl
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+var _ = Stringer(ZapErrorStringer)
+var _ = Stringer(ZapStacktraceStringer)
+
+// ZapFieldMapping is the default field mapping for zap-encoded logs, mirroring
+// the logrus "error"/"stack" mapping already understood by ErrorStringer: a
+// single "error" key maps to ZapErrorStringer, which is handed the whole
+// constructed LogZapError (both the "error" and "errorVerbose" keys of the
+// record), the same way FieldFinder builds a LogrusError for ErrorStringer.
+// NOTE: wiring this map into the caller's Config/FieldFinder is left to the
+// integrator, since the default field-mapping registry that the logrus
+// mapping lives in isn't part of this package.
+//
+// TODO(fnzr/jl): once that registry lands in this module, register
+// ZapFieldMapping as a default recipe the same way the logrus mapping is
+// registered, so pointing jl at zap output gets pretty errors and stacks out
+// of the box, as originally requested.
+var ZapFieldMapping = map[string]Stringer{
+	"error":      ZapErrorStringer,
+	"stacktrace": ZapStacktraceStringer,
+}
+
+// LogZapError mirrors LogrusError for zap's error shape: a short "error"
+// message alongside an "errorVerbose" field holding the full multi-line trace
+// produced by "%+v" on a pkg/errors error.
+type LogZapError struct {
+	Error        string `json:"error"`
+	ErrorVerbose string `json:"errorVerbose"`
+}
+
+// ZapErrorStringer stringifies a LogZapError to a multiline string, preferring
+// ErrorVerbose over Error when both are present. It falls back to the
+// DefaultStringer when v is not a LogZapError.
+func ZapErrorStringer(ctx *Context, v interface{}) string {
+	zapErr, ok := v.(LogZapError)
+	if !ok {
+		return DefaultStringer(ctx, v)
+	}
+	msg := zapErr.ErrorVerbose
+	if msg == "" {
+		msg = zapErr.Error
+	}
+	if msg == "" {
+		return DefaultStringer(ctx, v)
+	}
+	w := &bytes.Buffer{}
+	w.WriteString("\n  ")
+	lines := strings.Split(msg, "\n")
+	w.WriteString(lines[0])
+	for _, line := range lines[1:] {
+		w.WriteRune('\n')
+		w.WriteString("\t" + line)
+	}
+	return w.String()
+}
+
+// ZapStacktraceStringer stringifies a top-level "stacktrace" field, as
+// produced by zap.AddStacktrace or an unrecovered panic, where each frame is
+// "func\n\tfile:line". It pads every line with the same tab used by
+// ErrorStringer so stacks line up regardless of which stringer produced them.
+func ZapStacktraceStringer(ctx *Context, v interface{}) string {
+	rawMsg, ok := v.(json.RawMessage)
+	if !ok {
+		return DefaultStringer(ctx, v)
+	}
+	var stack string
+	if err := json.Unmarshal(rawMsg, &stack); err != nil || stack == "" {
+		return DefaultStringer(ctx, v)
+	}
+	lines := strings.Split(stack, "\n")
+	return "\t" + strings.Join(lines, "\n\t")
+}